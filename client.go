@@ -0,0 +1,90 @@
+package jaeger_client
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "go.opentelemetry.io/otel"
+    tracesdk "go.opentelemetry.io/otel/sdk/trace"
+    "go.opentelemetry.io/otel/trace"
+)
+
+// ErrInvalidHost is returned when none of OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_JAEGER_ENDPOINT,
+// or OTEL_EXPORTER_JAEGER_AGENT_HOST is set.
+var ErrInvalidHost = errors.New("jaeger: invalid agent host")
+
+// Client owns a tracer provider and the resources backing it. Unlike the package-level
+// functions, a Client can be used to run more than one instrumentation scope in the same
+// process, and its Shutdown does not depend on any package-level state.
+type Client struct {
+    serviceName    string
+    tracerProvider *tracesdk.TracerProvider
+}
+
+// Initialize builds a Client that sends traces to Jaeger via the UDP agent, the Jaeger
+// collector's HTTP endpoint, or an OTLP collector over gRPC/HTTP, and sets its tracer provider
+// and propagators as the global ones. Which exporter is used is selected automatically based on
+// which of OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_JAEGER_ENDPOINT, or
+// OTEL_EXPORTER_JAEGER_AGENT_HOST is set.
+// opts can be used to add attributes to every trace, override the sampler, override the
+// propagators, and so on. See WithAdditionalAttributes, WithSampler, and WithPropagators.
+func Initialize(serviceName string, opts ...Option) (*Client, error) {
+    if serviceName == "" {
+        return nil, errors.New("jaeger: invalid service name")
+    }
+
+    cfg := &config{sampler: defaultSampler()}
+    for _, opt := range opts {
+        opt(cfg)
+    }
+
+    // if none of the supported exporter environment variables are set, do not configure the
+    // tracer. This is fine, it just means that any traces we generate will be discarded.
+    // Prevents any weirdness involving traces when running the service locally.
+    exp, err := newExporter()
+    if err != nil {
+        return nil, err
+    }
+
+    res, err := buildResource(context.Background(), serviceName, cfg.additionalAttributes)
+    if err != nil {
+        return nil, err
+    }
+
+    tracerProvider := tracesdk.NewTracerProvider(
+        tracesdk.WithBatcher(exp),
+        tracesdk.WithSampler(cfg.sampler),
+        tracesdk.WithResource(res),
+    )
+    otel.SetTracerProvider(tracerProvider)
+
+    // set up inter-service trace propagation
+    propagators := cfg.propagators
+    if !cfg.propagatorsSet {
+        propagators = defaultPropagators
+    }
+    SetPropagators(propagators...)
+
+    return &Client{serviceName: serviceName, tracerProvider: tracerProvider}, nil
+}
+
+// Tracer returns a trace.Tracer for the given instrumentation scope, e.g. the name of the
+// library or package doing the instrumenting, as recommended by OTel.
+func (c *Client) Tracer(instrumentationName string, opts ...trace.TracerOption) trace.Tracer {
+    return c.tracerProvider.Tracer(instrumentationName, opts...)
+}
+
+// Shutdown flushes all traces from memory and shuts down the Client's tracer provider. It has a
+// timeout of 5 seconds, so it will not hang indefinitely if there is some problem with flushing.
+func (c *Client) Shutdown() error {
+    ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+    defer cancel()
+
+    if err := c.tracerProvider.Shutdown(ctx); err != nil {
+        return fmt.Errorf("failed to cleanly shut down tracer provider: %w", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,11 @@
+//go:build aws_ec2
+
+package jaeger_client
+
+import (
+    "go.opentelemetry.io/contrib/detectors/aws/ec2"
+)
+
+func init() {
+    cloudDetectors = append(cloudDetectors, ec2.NewResourceDetector())
+}
@@ -0,0 +1,11 @@
+//go:build gcp
+
+package jaeger_client
+
+import (
+    "go.opentelemetry.io/contrib/detectors/gcp"
+)
+
+func init() {
+    cloudDetectors = append(cloudDetectors, &gcp.GCE{}, &gcp.GKE{})
+}
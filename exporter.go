@@ -0,0 +1,43 @@
+package jaeger_client
+
+import (
+    "context"
+    "os"
+
+    "go.opentelemetry.io/otel/exporters/jaeger"
+    "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+    "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+    tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// newExporter builds a span exporter based on whichever OTel exporter environment variables are
+// set, preferring OTLP over the legacy Jaeger collector and agent protocols since the Jaeger
+// project has deprecated its native client protocol in favor of OTLP.
+func newExporter() (tracesdk.SpanExporter, error) {
+    if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
+        return newOTLPExporter()
+    }
+
+    if os.Getenv("OTEL_EXPORTER_JAEGER_ENDPOINT") != "" {
+        return jaeger.New(jaeger.WithCollectorEndpoint())
+    }
+
+    if os.Getenv("OTEL_EXPORTER_JAEGER_AGENT_HOST") != "" {
+        return jaeger.New(jaeger.WithAgentEndpoint())
+    }
+
+    return nil, ErrInvalidHost
+}
+
+// newOTLPExporter builds an OTLP exporter over gRPC or HTTP, depending on
+// OTEL_EXPORTER_OTLP_PROTOCOL. gRPC is the default, matching the OTel spec.
+func newOTLPExporter() (tracesdk.SpanExporter, error) {
+    ctx := context.Background()
+
+    switch os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL") {
+    case "http/protobuf":
+        return otlptracehttp.New(ctx)
+    default:
+        return otlptracegrpc.New(ctx)
+    }
+}
@@ -0,0 +1,80 @@
+package jaeger_client
+
+import (
+    "errors"
+    "testing"
+
+    "go.opentelemetry.io/otel/exporters/jaeger"
+)
+
+func TestNewExporter(t *testing.T) {
+    tests := []struct {
+        name       string
+        envs       map[string]string
+        wantErr    error
+        wantJaeger bool
+    }{
+        {
+            name:    "no exporter env vars set",
+            wantErr: ErrInvalidHost,
+        },
+        {
+            name:       "jaeger agent host set",
+            envs:       map[string]string{"OTEL_EXPORTER_JAEGER_AGENT_HOST": "localhost"},
+            wantJaeger: true,
+        },
+        {
+            name:       "jaeger collector endpoint set",
+            envs:       map[string]string{"OTEL_EXPORTER_JAEGER_ENDPOINT": "http://localhost:14268/api/traces"},
+            wantJaeger: true,
+        },
+        {
+            name: "otlp endpoint set defaults to grpc",
+            envs: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "localhost:4317"},
+        },
+        {
+            name: "otlp endpoint set with http/protobuf protocol",
+            envs: map[string]string{
+                "OTEL_EXPORTER_OTLP_ENDPOINT": "localhost:4318",
+                "OTEL_EXPORTER_OTLP_PROTOCOL": "http/protobuf",
+            },
+        },
+        {
+            name: "otlp endpoint takes precedence over jaeger collector and agent",
+            envs: map[string]string{
+                "OTEL_EXPORTER_OTLP_ENDPOINT":     "localhost:4317",
+                "OTEL_EXPORTER_JAEGER_ENDPOINT":   "http://localhost:14268/api/traces",
+                "OTEL_EXPORTER_JAEGER_AGENT_HOST": "localhost",
+            },
+        },
+        {
+            name: "jaeger collector endpoint takes precedence over jaeger agent",
+            envs: map[string]string{
+                "OTEL_EXPORTER_JAEGER_ENDPOINT":   "http://localhost:14268/api/traces",
+                "OTEL_EXPORTER_JAEGER_AGENT_HOST": "localhost",
+            },
+            wantJaeger: true,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            for k, v := range tt.envs {
+                t.Setenv(k, v)
+            }
+
+            exp, err := newExporter()
+            if !errors.Is(err, tt.wantErr) {
+                t.Fatalf("newExporter() error = %v, want %v", err, tt.wantErr)
+            }
+
+            if tt.wantErr == nil && exp == nil {
+                t.Fatal("newExporter() returned a nil exporter with no error")
+            }
+
+            if _, isJaeger := exp.(*jaeger.Exporter); isJaeger != tt.wantJaeger {
+                t.Errorf("newExporter() returned %T, want Jaeger exporter = %v", exp, tt.wantJaeger)
+            }
+        })
+    }
+}
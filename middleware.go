@@ -0,0 +1,21 @@
+package jaeger_client
+
+import (
+    "net/http"
+
+    "go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Middleware wraps next with OpenTelemetry HTTP server instrumentation, naming each span via
+// SpanNameFormatter. Use InjectSpanName to give a handler a more descriptive span name than the
+// default "<method> <path>".
+func Middleware(next http.Handler) http.Handler {
+    return otelhttp.NewHandler(next, "", otelhttp.WithSpanNameFormatter(SpanNameFormatter))
+}
+
+// Transport wraps base with OpenTelemetry HTTP client instrumentation, naming each span via
+// SpanNameFormatter. Use InjectSpanName on the outgoing request's context to override the
+// default "<method> <path>" span name.
+func Transport(base http.RoundTripper) http.RoundTripper {
+    return otelhttp.NewTransport(base, otelhttp.WithSpanNameFormatter(SpanNameFormatter))
+}
@@ -0,0 +1,35 @@
+package jaeger_client
+
+import (
+    "go.opentelemetry.io/otel/attribute"
+    "go.opentelemetry.io/otel/propagation"
+    tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// config holds the settings applied by Options passed to InitializeJaeger.
+type config struct {
+    additionalAttributes []attribute.KeyValue
+    sampler tracesdk.Sampler
+    propagators []propagation.TextMapPropagator
+    propagatorsSet bool
+}
+
+// Option configures how InitializeJaeger sets up tracing.
+type Option func(*config)
+
+// WithAdditionalAttributes adds attributes that should be added to every trace, on top of the
+// service name.
+func WithAdditionalAttributes(attrs ...attribute.KeyValue) Option {
+    return func(c *config) {
+        c.additionalAttributes = append(c.additionalAttributes, attrs...)
+    }
+}
+
+// WithSampler overrides the sampler used to decide which traces are recorded. If not given, the
+// sampler is derived from the OTEL_TRACES_SAMPLER / OTEL_TRACES_SAMPLER_ARG environment
+// variables, falling back to a parent-based always-on sampler.
+func WithSampler(sampler tracesdk.Sampler) Option {
+    return func(c *config) {
+        c.sampler = sampler
+    }
+}
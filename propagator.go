@@ -0,0 +1,51 @@
+package jaeger_client
+
+import (
+    "go.opentelemetry.io/otel"
+    "go.opentelemetry.io/otel/propagation"
+
+    "go.opentelemetry.io/contrib/propagators/b3"
+    jaegerpropagator "go.opentelemetry.io/contrib/propagators/jaeger"
+    "go.opentelemetry.io/contrib/propagators/ot"
+)
+
+// defaultPropagators is used when InitializeJaeger is not given a WithPropagators Option.
+var defaultPropagators = []propagation.TextMapPropagator{propagation.TraceContext{}}
+
+// JaegerPropagator returns a propagator that reads and writes the legacy Jaeger uber-trace-id
+// header, for interop with services still running the native Jaeger client.
+func JaegerPropagator() propagation.TextMapPropagator {
+    return jaegerpropagator.Jaeger{}
+}
+
+// B3Propagator returns a propagator for Zipkin's B3 headers. By default it injects using the
+// single-header format; pass b3.WithInjectEncoding to inject the multi-header format instead.
+func B3Propagator(opts ...b3.Option) propagation.TextMapPropagator {
+    return b3.New(opts...)
+}
+
+// OTPropagator returns a propagator for the ot-tracer-* headers used by OpenTracing's Basic
+// Tracer implementation.
+func OTPropagator() propagation.TextMapPropagator {
+    return ot.OT{}
+}
+
+// WithPropagators sets the TextMapPropagators used for inter-service trace propagation, replacing
+// the default of W3C TraceContext only. This is useful when this client sits between services
+// that still emit legacy Jaeger or Zipkin B3 headers, so trace context isn't dropped at the
+// boundary. Compose any subset of propagation.TraceContext, propagation.Baggage,
+// JaegerPropagator, B3Propagator, and OTPropagator.
+// Passing no propagators disables propagation entirely, rather than falling back to the default.
+func WithPropagators(propagators ...propagation.TextMapPropagator) Option {
+    return func(c *config) {
+        c.propagators = propagators
+        c.propagatorsSet = true
+    }
+}
+
+// SetPropagators sets the global TextMapPropagator to a composite of the given propagators. It is
+// exposed separately from InitializeJaeger so that callers can change propagators at runtime
+// without tearing down and reinitializing the tracer provider.
+func SetPropagators(propagators ...propagation.TextMapPropagator) {
+    otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagators...))
+}
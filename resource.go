@@ -0,0 +1,43 @@
+package jaeger_client
+
+import (
+    "context"
+    "errors"
+
+    "go.opentelemetry.io/otel/attribute"
+    "go.opentelemetry.io/otel/sdk/resource"
+    semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+)
+
+// cloudDetectors is populated by build-tag-gated files (e.g. detectors_gcp.go, built with
+// `-tags gcp`) with resource.Detectors for cloud platforms that require pulling in a cloud
+// provider's SDK. It is empty by default so this module has no such dependency unless a caller
+// opts in.
+var cloudDetectors []resource.Detector
+
+// buildResource detects the host, process, OS, and any OTEL_RESOURCE_ATTRIBUTES, merges in any
+// cloudDetectors, and then overlays serviceName and additionalAttributes so they always win over
+// detected values.
+// Container detection (resource.WithContainer) is intentionally not included here:
+// go.opentelemetry.io/otel/sdk v1.3.0, the version pinned in go.mod, predates that option. Add it
+// once the sdk dependency is bumped to a version that has it.
+func buildResource(ctx context.Context, serviceName string, additionalAttributes []attribute.KeyValue) (*resource.Resource, error) {
+    detected, err := resource.New(ctx,
+        resource.WithHost(),
+        resource.WithProcess(),
+        resource.WithOS(),
+        resource.WithTelemetrySDK(),
+        resource.WithFromEnv(),
+        resource.WithDetectors(cloudDetectors...),
+    )
+    // a partial resource just means one detector failed; the rest of the attributes are still
+    // usable, so don't treat it as fatal.
+    if err != nil && !errors.Is(err, resource.ErrPartialResource) {
+        return nil, err
+    }
+
+    overrides := []attribute.KeyValue{semconv.ServiceNameKey.String(serviceName)}
+    overrides = append(overrides, additionalAttributes...)
+
+    return resource.Merge(detected, resource.NewWithAttributes(semconv.SchemaURL, overrides...))
+}
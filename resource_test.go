@@ -0,0 +1,55 @@
+package jaeger_client
+
+import (
+    "context"
+    "testing"
+
+    "go.opentelemetry.io/otel/attribute"
+    semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+)
+
+func attrValue(t *testing.T, attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+    t.Helper()
+
+    for _, kv := range attrs {
+        if kv.Key == key {
+            return kv.Value, true
+        }
+    }
+
+    return attribute.Value{}, false
+}
+
+func TestBuildResourceSetsServiceName(t *testing.T) {
+    res, err := buildResource(context.Background(), "my-service", nil)
+    if err != nil {
+        t.Fatalf("buildResource() error = %v", err)
+    }
+
+    got, ok := attrValue(t, res.Attributes(), semconv.ServiceNameKey)
+    if !ok {
+        t.Fatal("buildResource() result is missing service.name")
+    }
+    if got.AsString() != "my-service" {
+        t.Errorf("service.name = %q, want %q", got.AsString(), "my-service")
+    }
+}
+
+func TestBuildResourceAdditionalAttributesOverrideDetectedOnes(t *testing.T) {
+    t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "custom.attr=from-env")
+
+    res, err := buildResource(context.Background(), "my-service", []attribute.KeyValue{
+        attribute.String("custom.attr", "from-caller"),
+    })
+    if err != nil {
+        t.Fatalf("buildResource() error = %v", err)
+    }
+
+    got, ok := attrValue(t, res.Attributes(), attribute.Key("custom.attr"))
+    if !ok {
+        t.Fatal("buildResource() result is missing custom.attr")
+    }
+    if got.AsString() != "from-caller" {
+        t.Errorf("custom.attr = %q, want the additionalAttributes value %q to win over the detected one", got.AsString(), "from-caller")
+    }
+}
@@ -0,0 +1,44 @@
+package jaeger_client
+
+import (
+    "os"
+    "strconv"
+
+    tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// defaultSampler builds the sampler to use when no WithSampler Option is given, based on the
+// OTEL_TRACES_SAMPLER / OTEL_TRACES_SAMPLER_ARG environment variables described by the OTel spec.
+// This lets operators dial sampling down in production without recompiling. Only the
+// parentbased_* variants (and the ratio-based sampler, so downstream services honor upstream
+// sampling decisions) are wrapped in ParentBased; always_on and always_off are unconditional, as
+// the spec requires.
+func defaultSampler() tracesdk.Sampler {
+    switch os.Getenv("OTEL_TRACES_SAMPLER") {
+    case "always_on":
+        return tracesdk.AlwaysSample()
+    case "always_off":
+        return tracesdk.NeverSample()
+    case "traceidratio":
+        return tracesdk.ParentBased(tracesdk.TraceIDRatioBased(samplerRatioFromEnv()))
+    case "parentbased_always_off":
+        return tracesdk.ParentBased(tracesdk.NeverSample())
+    case "parentbased_traceidratio":
+        return tracesdk.ParentBased(tracesdk.TraceIDRatioBased(samplerRatioFromEnv()))
+    case "parentbased_always_on", "":
+        return tracesdk.ParentBased(tracesdk.AlwaysSample())
+    default:
+        return tracesdk.ParentBased(tracesdk.AlwaysSample())
+    }
+}
+
+// samplerRatioFromEnv parses OTEL_TRACES_SAMPLER_ARG as the fraction of traces to sample,
+// defaulting to 1.0 (sample everything) if it is unset or invalid.
+func samplerRatioFromEnv() float64 {
+    ratio, err := strconv.ParseFloat(os.Getenv("OTEL_TRACES_SAMPLER_ARG"), 64)
+    if err != nil {
+        return 1.0
+    }
+
+    return ratio
+}
@@ -0,0 +1,109 @@
+package jaeger_client
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestDefaultSampler(t *testing.T) {
+    tests := []struct {
+        name            string
+        samplerEnv      string
+        samplerArgEnv   string
+        wantParentBased bool
+        wantDescription string
+    }{
+        {
+            name:            "unset defaults to parent-based always on",
+            wantParentBased: true,
+            wantDescription: "AlwaysOnSampler",
+        },
+        {
+            name:            "always_on is unconditional, not parent-based",
+            samplerEnv:      "always_on",
+            wantParentBased: false,
+            wantDescription: "AlwaysOnSampler",
+        },
+        {
+            name:            "always_off is unconditional, not parent-based",
+            samplerEnv:      "always_off",
+            wantParentBased: false,
+            wantDescription: "AlwaysOffSampler",
+        },
+        {
+            name:            "traceidratio is wrapped in parent-based",
+            samplerEnv:      "traceidratio",
+            samplerArgEnv:   "0.25",
+            wantParentBased: true,
+            wantDescription: "TraceIDRatioBased{0.25}",
+        },
+        {
+            name:            "parentbased_always_on",
+            samplerEnv:      "parentbased_always_on",
+            wantParentBased: true,
+            wantDescription: "AlwaysOnSampler",
+        },
+        {
+            name:            "parentbased_always_off",
+            samplerEnv:      "parentbased_always_off",
+            wantParentBased: true,
+            wantDescription: "AlwaysOffSampler",
+        },
+        {
+            name:            "parentbased_traceidratio",
+            samplerEnv:      "parentbased_traceidratio",
+            samplerArgEnv:   "0.5",
+            wantParentBased: true,
+            wantDescription: "TraceIDRatioBased{0.5}",
+        },
+        {
+            name:            "unrecognized value falls back to always on",
+            samplerEnv:      "not_a_real_sampler",
+            wantParentBased: true,
+            wantDescription: "AlwaysOnSampler",
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if tt.samplerEnv != "" {
+                t.Setenv("OTEL_TRACES_SAMPLER", tt.samplerEnv)
+            }
+            if tt.samplerArgEnv != "" {
+                t.Setenv("OTEL_TRACES_SAMPLER_ARG", tt.samplerArgEnv)
+            }
+
+            got := defaultSampler().Description()
+            if hasParentBased := strings.Contains(got, "ParentBased"); hasParentBased != tt.wantParentBased {
+                t.Errorf("defaultSampler().Description() = %q, want ParentBased wrapping = %v", got, tt.wantParentBased)
+            }
+            if !strings.Contains(got, tt.wantDescription) {
+                t.Errorf("defaultSampler().Description() = %q, want it to contain %q", got, tt.wantDescription)
+            }
+        })
+    }
+}
+
+func TestSamplerRatioFromEnv(t *testing.T) {
+    tests := []struct {
+        name string
+        env  string
+        want float64
+    }{
+        {name: "unset defaults to 1.0", want: 1.0},
+        {name: "invalid value defaults to 1.0", env: "not-a-float", want: 1.0},
+        {name: "valid value is parsed", env: "0.1", want: 0.1},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if tt.env != "" {
+                t.Setenv("OTEL_TRACES_SAMPLER_ARG", tt.env)
+            }
+
+            if got := samplerRatioFromEnv(); got != tt.want {
+                t.Errorf("samplerRatioFromEnv() = %v, want %v", got, tt.want)
+            }
+        })
+    }
+}
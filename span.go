@@ -0,0 +1,45 @@
+package jaeger_client
+
+import (
+    "context"
+
+    "go.opentelemetry.io/otel/attribute"
+    "go.opentelemetry.io/otel/codes"
+    "go.opentelemetry.io/otel/trace"
+)
+
+// RecordError records err as an exception event on the span in ctx and marks the span's status
+// as an error. It is a no-op if err is nil, matching the nil-safety of span.RecordError.
+func RecordError(ctx context.Context, err error) {
+    if err == nil {
+        return
+    }
+
+    span := trace.SpanFromContext(ctx)
+    span.RecordError(err)
+    span.SetStatus(codes.Error, err.Error())
+}
+
+// AddEvent adds an event with the given name and attributes to the span in ctx.
+func AddEvent(ctx context.Context, name string, attrs ...attribute.KeyValue) {
+    trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(attrs...))
+}
+
+// SetAttributes sets attributes on the span in ctx.
+func SetAttributes(ctx context.Context, attrs ...attribute.KeyValue) {
+    trace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+// WithSpan starts a child span named name, runs fn with the resulting context, and ends the span
+// when fn returns. If fn returns an error, it is recorded on the span before the span ends.
+func WithSpan(ctx context.Context, name string, fn func(context.Context) error) error {
+    ctx, span := SpanFromContext(ctx, name)
+    defer span.End()
+
+    if err := fn(ctx); err != nil {
+        RecordError(ctx, err)
+        return err
+    }
+
+    return nil
+}